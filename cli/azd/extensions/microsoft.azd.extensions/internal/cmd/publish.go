@@ -141,7 +141,7 @@ func runPublishAction(ctx context.Context, flags *publishFlags) error {
 			return err
 		}
 
-		release, err = ghCli.ViewRelease(absExtensionPath, flags.repository, tagName)
+		release, err = ghCli.ViewRelease(ctx, absExtensionPath, flags.repository, tagName)
 		if err != nil {
 			if errors.Is(err, github.ErrReleaseNotFound) {
 				return internal.NewUserFriendlyError("Github Release not found", strings.Join([]string{
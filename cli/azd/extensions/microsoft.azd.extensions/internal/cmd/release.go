@@ -9,20 +9,25 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/azure/azure-dev/cli/azd/extensions/microsoft.azd.extensions/internal"
-	"github.com/azure/azure-dev/cli/azd/extensions/microsoft.azd.extensions/internal/github"
 	"github.com/azure/azure-dev/cli/azd/extensions/microsoft.azd.extensions/internal/models"
 	"github.com/azure/azure-dev/cli/azd/pkg/azdext"
 	"github.com/azure/azure-dev/cli/azd/pkg/common"
 	"github.com/azure/azure-dev/cli/azd/pkg/output"
 	"github.com/azure/azure-dev/cli/azd/pkg/ux"
+	"github.com/blang/semver/v4"
+	"github.com/sethvargo/go-retry"
 	"github.com/spf13/cobra"
 )
 
 type releaseFlags struct {
+	provider   string
 	repository string
 	artifacts  string
 	title      string
@@ -32,6 +37,16 @@ type releaseFlags struct {
 	preRelease bool
 	draft      bool
 	confirm    bool
+	dryRun     bool
+
+	notesFromCommits bool
+	noChecksums      bool
+	retries          int
+	replace          bool
+	quiet            bool
+	timeout          time.Duration
+
+	allowPrereleaseVersion bool
 }
 
 func newReleaseCommand() *cobra.Command {
@@ -40,30 +55,46 @@ func newReleaseCommand() *cobra.Command {
 		Use:   "release",
 		Short: "Create a new extension release from the packaged artifacts",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			internal.WriteCommandHeader(
-				"Release azd extension version (azd x release)",
-				"Creates a new Github release for the azd extension project",
-			)
+			if !flags.quiet {
+				internal.WriteCommandHeader(
+					"Release azd extension version (azd x release)",
+					"Creates a new GitHub or GitLab release for the azd extension project",
+				)
+			}
 
 			err := runReleaseAction(cmd.Context(), flags)
 			if err != nil {
 				return err
 			}
 
+			if flags.quiet {
+				return nil
+			}
+
+			if flags.dryRun {
+				internal.WriteCommandSuccess("Dry run completed, no release was created")
+				return nil
+			}
+
 			internal.WriteCommandSuccess("Extension released successfully")
 			return nil
 		},
 	}
 
+	releaseCmd.Flags().StringVar(
+		&flags.provider,
+		"provider", flags.provider,
+		"Release provider to use (github or gitlab). Inferred from the git remote when not specified",
+	)
 	releaseCmd.Flags().StringVarP(
 		&flags.repository,
 		"repo", "r", flags.repository,
-		"Github repository to create the release in (e.g. owner/repo)",
+		"Repository to create the release in (e.g. owner/repo). Inferred from the git origin remote when not specified",
 	)
 	releaseCmd.Flags().StringVar(
 		&flags.artifacts,
 		"artifacts", flags.artifacts,
-		"Path to the artifacts to upload to the release (e.g. ./artifacts/*.zip)",
+		"Comma separated paths or globs of the artifacts to upload to the release (e.g. ./artifacts/*.zip,./README.md)",
 	)
 	releaseCmd.Flags().StringVarP(
 		&flags.title,
@@ -80,6 +111,11 @@ func newReleaseCommand() *cobra.Command {
 		"notes-file", "F", flags.notesFile,
 		"Read release notes from file (use \"-\" to read from standard input)",
 	)
+	releaseCmd.Flags().BoolVar(
+		&flags.notesFromCommits,
+		"notes-from-commits", flags.notesFromCommits,
+		"Generate release notes from the commits since the previous release when no notes are provided",
+	)
 	releaseCmd.Flags().StringVarP(
 		&flags.version,
 		"version", "v", flags.version,
@@ -100,8 +136,41 @@ func newReleaseCommand() *cobra.Command {
 		"confirm", flags.confirm,
 		"Skip confirmation prompt",
 	)
-
-	releaseCmd.MarkFlagRequired("repo")
+	releaseCmd.Flags().BoolVar(
+		&flags.allowPrereleaseVersion,
+		"allow-prerelease-version", flags.allowPrereleaseVersion,
+		"Allow versions with a pre-release suffix (e.g. 1.2.3-beta.1)",
+	)
+	releaseCmd.Flags().BoolVar(
+		&flags.dryRun,
+		"dry-run", flags.dryRun,
+		"Validate the artifacts and print the release command without creating the release",
+	)
+	releaseCmd.Flags().BoolVar(
+		&flags.noChecksums,
+		"no-checksums", flags.noChecksums,
+		"Skip generating and uploading a checksums.txt manifest for the artifacts",
+	)
+	releaseCmd.Flags().IntVar(
+		&flags.retries,
+		"retries", 2,
+		"Number of times to retry creating the release after a transient failure",
+	)
+	releaseCmd.Flags().BoolVar(
+		&flags.replace,
+		"replace", flags.replace,
+		"Delete an existing release and tag with the same version before creating the release",
+	)
+	releaseCmd.Flags().BoolVarP(
+		&flags.quiet,
+		"quiet", "q", flags.quiet,
+		"Only print the URL of the created release. Implies --confirm",
+	)
+	releaseCmd.Flags().DurationVar(
+		&flags.timeout,
+		"timeout", 10*time.Minute,
+		"Maximum time to wait for the release to be created, including retries, before the operation is cancelled",
+	)
 
 	return releaseCmd
 }
@@ -132,6 +201,10 @@ func runReleaseAction(ctx context.Context, flags *releaseFlags) error {
 		flags.version = extensionMetadata.Version
 	}
 
+	if err := validateReleaseVersion(flags.version, flags.allowPrereleaseVersion); err != nil {
+		return err
+	}
+
 	if flags.title == "" {
 		flags.title = fmt.Sprintf("%s (%s)", extensionMetadata.DisplayName, flags.version)
 	}
@@ -145,6 +218,19 @@ func runReleaseAction(ctx context.Context, flags *releaseFlags) error {
 		flags.artifacts = filepath.Join(localRegistryArtifactsPath, extensionMetadata.Id, flags.version, "*.zip")
 	}
 
+	// Quiet mode is intended for automation so there is nobody to confirm the release
+	if flags.quiet {
+		flags.confirm = true
+	}
+
+	if flags.retries < 0 {
+		return errors.New("--retries must be zero or greater")
+	}
+
+	if flags.timeout <= 0 {
+		return errors.New("--timeout must be greater than zero")
+	}
+
 	if flags.notes != "" && flags.notesFile != "" {
 		return errors.New("only one of --notes or --notes-file can be specified")
 	}
@@ -167,6 +253,17 @@ func runReleaseAction(ctx context.Context, flags *releaseFlags) error {
 		}
 	}
 
+	tagPrefix := fmt.Sprintf("azd-ext-%s_", extensionMetadata.SafeDashId())
+
+	// Generate notes from the commit log since the previous release when requested
+	if flags.notes == "" && flags.notesFromCommits {
+		notes, err := commitReleaseNotes(absExtensionPath, tagPrefix)
+		if err != nil {
+			return err
+		}
+		flags.notes = notes
+	}
+
 	// Automatically include CHANGELOG.md if no notes are provided
 	if flags.notes == "" {
 		fileInfo, err := os.Stat("CHANGELOG.md")
@@ -179,40 +276,128 @@ func runReleaseAction(ctx context.Context, flags *releaseFlags) error {
 		}
 	}
 
-	tagName := fmt.Sprintf("azd-ext-%s_%s", extensionMetadata.SafeDashId(), flags.version)
+	tagName := tagPrefix + flags.version
 
-	// Initialize GitHub CLI wrapper
-	ghCli, err := github.NewGitHubCli()
+	// Initialize the release provider (GitHub or GitLab) wrapper
+	provider, err := newReleaseProvider(flags.provider, absExtensionPath)
 	if err != nil {
-		return fmt.Errorf("failed to initialize GitHub CLI: %w", err)
+		return err
+	}
+
+	// Default to the repository of the git origin remote
+	if flags.repository == "" {
+		remoteUrl, err := gitOriginRemoteUrl(absExtensionPath)
+		if err == nil {
+			flags.repository, err = repositoryFromRemoteUrl(remoteUrl, provider.DefaultHost())
+		}
+
+		if err != nil {
+			return internal.NewUserFriendlyError(
+				`required flag(s) "repo" not set`,
+				fmt.Sprintf(
+					"The repository could not be inferred from the git origin remote (%s).\n"+
+						"Specify the repository with the --repo flag (e.g. owner/repo).",
+					err.Error(),
+				),
+			)
+		}
+	}
+
+	// Build options map for CreateRelease
+	releaseOptions := map[string]string{}
+	if flags.notes != "" {
+		releaseOptions["notes"] = flags.notes
+	}
+	if flags.title != "" {
+		releaseOptions["title"] = flags.title
+	}
+	if flags.repository != "" {
+		releaseOptions["repo"] = flags.repository
+	}
+	if flags.preRelease {
+		releaseOptions["prerelease"] = "true"
+	}
+	if flags.draft {
+		releaseOptions["draft"] = "true"
 	}
 
-	// Check if GitHub CLI is installed using the new method that returns UserFriendlyError
-	if err := ghCli.CheckAndGetInstallError(); err != nil {
+	// Reject unsupported options before any work is done or existing releases are replaced
+	if err := provider.ValidateOptions(releaseOptions); err != nil {
+		return err
+	}
+
+	// Check if the provider CLI is installed using the method that returns UserFriendlyError
+	if err := provider.CheckAndGetInstallError(); err != nil {
 		return err // Pass the UserFriendlyError through
 	}
 
-	repo, err := ghCli.ViewRepository(absExtensionPath, flags.repository)
+	repo, err := provider.ViewRepository(absExtensionPath, flags.repository)
 	if err != nil {
 		return err
 	}
 
-	fmt.Println()
-	fmt.Printf("%s: %s\n", output.WithBold("Artifacts"), flags.artifacts)
-	fmt.Printf("%s: %s - %s\n",
-		output.WithBold("GitHub Repo"),
-		repo.Name,
-		output.WithHyperlink(repo.Url, "View Repo"),
-	)
-	fmt.Printf("%s: %s (%s)\n", "GitHub Release", flags.title, tagName)
-	fmt.Printf("%s: %t\n", output.WithBold("Prerelease"), flags.preRelease)
-	fmt.Printf("%s: %t\n", output.WithBold("Draft"), flags.draft)
+	// Fail early when the release already exists unless the user has asked to replace it
+	existingRelease, err := provider.ViewRelease(ctx, absExtensionPath, flags.repository, tagName)
+	if err != nil && !isReleaseNotFoundError(err) {
+		return fmt.Errorf("failed to check for existing release: %w", err)
+	}
+
+	if existingRelease != nil && !flags.replace {
+		return internal.NewUserFriendlyError(
+			fmt.Sprintf("Release %s already exists", tagName),
+			fmt.Sprintf(
+				"Bump the version of the %s extension or use the --replace flag to overwrite the existing release.",
+				output.WithHighLightFormat(extensionMetadata.Id),
+			),
+		)
+	}
+
+	// A tag without a release would otherwise be reused by the new release and may point at an older commit
+	existingTag := false
+	if existingRelease == nil {
+		existingTag, err = provider.TagExists(ctx, absExtensionPath, flags.repository, tagName)
+		if err != nil {
+			return fmt.Errorf("failed to check for existing tag: %w", err)
+		}
+
+		if existingTag && !flags.replace {
+			return internal.NewUserFriendlyError(
+				fmt.Sprintf("Tag %s already exists", tagName),
+				fmt.Sprintf(
+					"Bump the version of the %s extension or use the --replace flag to delete the existing tag.",
+					output.WithHighLightFormat(extensionMetadata.Id),
+				),
+			)
+		}
+	}
+
+	if !flags.quiet {
+		fmt.Println()
+		fmt.Printf("%s: %s\n", output.WithBold("Artifacts"), flags.artifacts)
+		fmt.Printf("%s: %s - %s\n",
+			output.WithBold("%s Repo", provider.DisplayName()),
+			repo.Name,
+			output.WithHyperlink(repo.Url, "View Repo"),
+		)
+		fmt.Printf("%s: %s (%s)\n", fmt.Sprintf("%s Release", provider.DisplayName()), flags.title, tagName)
+		fmt.Printf("%s: %t\n", output.WithBold("Prerelease"), flags.preRelease)
+		fmt.Printf("%s: %t\n", output.WithBold("Draft"), flags.draft)
+		if existingRelease != nil {
+			fmt.Printf("%s: %s\n",
+				output.WithBold("Replacing"),
+				output.WithHyperlink(existingRelease.Url, existingRelease.TagName),
+			)
+		} else if existingTag {
+			fmt.Printf("%s: %s (tag only)\n", output.WithBold("Replacing"), tagName)
+		}
+	}
 
-	if !flags.confirm {
+	// Nothing is created during a dry run so there is nothing to confirm
+	if !flags.confirm && !flags.dryRun {
 		fmt.Println()
 		confirmReleaseResponse, err := azdClient.Prompt().Confirm(ctx, &azdext.ConfirmRequest{
 			Options: &azdext.ConfirmOptions{
-				Message:      "Are you sure you want to create the GitHub release?",
+				Message:      fmt.Sprintf("Are you sure you want to create the %s release?", provider.DisplayName()),
 				DefaultValue: internal.ToPtr(false),
 				Placeholder:  "no",
 			},
@@ -226,13 +411,20 @@ func runReleaseAction(ctx context.Context, flags *releaseFlags) error {
 		}
 	}
 
-	var release *github.Release
+	var release *releaseResult
+	var artifactFiles []string
+	var checksumsPath string
 
-	taskList := ux.NewTaskList(nil).
+	var taskListOptions *ux.TaskListOptions
+	if flags.quiet {
+		taskListOptions = &ux.TaskListOptions{Writer: io.Discard}
+	}
+
+	taskList := ux.NewTaskList(taskListOptions).
 		AddTask(ux.TaskOptions{
 			Title: "Validating artifacts",
 			Action: func(spf ux.SetProgressFunc) (ux.TaskState, error) {
-				files, err := filepath.Glob(flags.artifacts)
+				files, err := expandArtifactPatterns(flags.artifacts)
 				if err != nil {
 					return ux.Error, common.NewDetailedError("Artifacts not found",
 						fmt.Errorf("failed to find artifacts: %w", err),
@@ -245,73 +437,174 @@ func runReleaseAction(ctx context.Context, flags *releaseFlags) error {
 					)
 				}
 
-				spf(fmt.Sprintf("Found %d artifacts", len(files)))
+				reservedNames := []string{}
+				if !flags.noChecksums {
+					reservedNames = append(reservedNames, checksumsFileName)
+				}
+
+				if err := checkDuplicateArtifactNames(files, reservedNames); err != nil {
+					return ux.Error, common.NewDetailedError("Duplicate artifact names", err)
+				}
+
+				totalSize, err := validateArtifacts(files, spf)
+				if err != nil {
+					return ux.Error, common.NewDetailedError("Invalid artifact", err)
+				}
+
+				artifactFiles = files
+				spf(fmt.Sprintf("Found %d artifacts (%s)", len(files), formatFileSize(totalSize)))
 
 				return ux.Success, nil
 			},
-		}).
-		AddTask(
-			ux.TaskOptions{
-				Title: "Creating Github release",
-				Action: func(spf ux.SetProgressFunc) (ux.TaskState, error) {
-					// Get the artifact files
-					files, err := filepath.Glob(flags.artifacts)
-					if err != nil {
-						return ux.Error, common.NewDetailedError("Artifacts not found",
-							fmt.Errorf("failed to find artifacts: %w", err),
-						)
-					}
+		})
 
-					// Build options map for CreateRelease
-					releaseOptions := map[string]string{}
-					if flags.notes != "" {
-						releaseOptions["notes"] = flags.notes
-					}
-					if flags.title != "" {
-						releaseOptions["title"] = flags.title
-					}
-					if flags.repository != "" {
-						releaseOptions["repo"] = flags.repository
-					}
-					if flags.preRelease {
-						releaseOptions["prerelease"] = "true"
-					}
-					if flags.draft {
-						releaseOptions["draft"] = "true"
-					}
+	if !flags.noChecksums {
+		checksumsDir, err := os.MkdirTemp("", "azd-ext-release-")
+		if err != nil {
+			return fmt.Errorf("failed to create temp directory for checksums: %w", err)
+		}
+
+		// The dry run command references the manifest, so it is kept for the command to be run as printed
+		if !flags.dryRun {
+			defer os.RemoveAll(checksumsDir)
+		}
+
+		taskList.AddTask(ux.TaskOptions{
+			Title: "Generating checksums",
+			Action: func(spf ux.SetProgressFunc) (ux.TaskState, error) {
+				checksumsPath = filepath.Join(checksumsDir, checksumsFileName)
+				if err := writeChecksumManifest(checksumsPath, artifactFiles); err != nil {
+					return ux.Error, common.NewDetailedError("Checksums failed", err)
+				}
+
+				spf(fmt.Sprintf("Generated checksums for %d artifacts", len(artifactFiles)))
+				artifactFiles = append(artifactFiles, checksumsPath)
 
-					// Create the release and get the result directly
-					releaseResult, err := ghCli.CreateRelease(absExtensionPath, tagName, releaseOptions, files)
-					if err != nil {
-						if errors.Is(err, github.ErrReleaseAlreadyExists) {
-							err = internal.NewUserFriendlyError("Release already exists",
-								strings.Join([]string{
-									fmt.Sprintf(
-										"The %s extension already has been released with version %s",
-										output.WithHighLightFormat(extensionMetadata.Id),
-										output.WithHighLightFormat(flags.version),
-									),
-									"Please update the version number or delete the existing release before trying again.",
-								}, "\n"),
-							)
-						}
-
-						return ux.Error, common.NewDetailedError("Release failed", err)
+				return ux.Success, nil
+			},
+		})
+	}
+
+	if existingRelease != nil {
+		taskList.AddTask(ux.TaskOptions{
+			Title: "Deleting existing release",
+			Action: func(spf ux.SetProgressFunc) (ux.TaskState, error) {
+				if flags.dryRun {
+					spf("Dry run")
+					return ux.Skipped, nil
+				}
+
+				if err := provider.DeleteRelease(absExtensionPath, flags.repository, tagName); err != nil {
+					return ux.Error, common.NewDetailedError("Delete release failed", err)
+				}
+
+				return ux.Success, nil
+			},
+		})
+	}
+
+	if existingTag {
+		taskList.AddTask(ux.TaskOptions{
+			Title: "Deleting existing tag",
+			Action: func(spf ux.SetProgressFunc) (ux.TaskState, error) {
+				if flags.dryRun {
+					spf("Dry run")
+					return ux.Skipped, nil
+				}
+
+				if err := provider.DeleteTag(absExtensionPath, flags.repository, tagName); err != nil {
+					return ux.Error, common.NewDetailedError("Delete tag failed", err)
+				}
+
+				return ux.Success, nil
+			},
+		})
+	}
+
+	taskList.AddTask(
+		ux.TaskOptions{
+			Title: fmt.Sprintf("Creating %s release", provider.DisplayName()),
+			Action: func(spf ux.SetProgressFunc) (ux.TaskState, error) {
+				if flags.dryRun {
+					spf("Dry run")
+					return ux.Skipped, nil
+				}
+
+				result, err := createReleaseWithRetry(
+					ctx,
+					provider,
+					absExtensionPath,
+					tagName,
+					releaseOptions,
+					artifactFiles,
+					releaseRetryOptions{
+						retries: flags.retries,
+						timeout: flags.timeout,
+						backoff: 2 * time.Second,
+					},
+					spf,
+				)
+				if err != nil {
+					if isReleaseAlreadyExistsError(err) {
+						err = internal.NewUserFriendlyError("Release already exists",
+							strings.Join([]string{
+								fmt.Sprintf(
+									"The %s extension already has been released with version %s",
+									output.WithHighLightFormat(extensionMetadata.Id),
+									output.WithHighLightFormat(flags.version),
+								),
+								"Please update the version number or delete the existing release before trying again.",
+							}, "\n"),
+						)
 					}
 
-					// Store the release for later use
-					release = releaseResult
+					return ux.Error, common.NewDetailedError("Release failed", err)
+				}
 
-					return ux.Success, nil
-				},
-			})
+				// Store the release for later use
+				release = result
+
+				return ux.Success, nil
+			},
+		})
 
 	if err := taskList.Run(); err != nil {
 		return err
 	}
 
+	if flags.dryRun {
+		command := formatCommand(provider.CreateReleaseCommand(tagName, releaseOptions, artifactFiles))
+		if flags.quiet {
+			fmt.Println(command)
+			return nil
+		}
+
+		fmt.Println()
+		fmt.Println(output.WithBold("Artifacts:"))
+		for _, file := range artifactFiles {
+			if file == checksumsPath {
+				fmt.Printf("  - %s %s\n", file, output.WithGrayFormat("(generated)"))
+				continue
+			}
+
+			fmt.Printf("  - %s\n", file)
+		}
+
+		fmt.Println()
+		fmt.Println(output.WithBold("Command:"))
+		fmt.Println(command)
+		fmt.Println()
+
+		return nil
+	}
+
+	if flags.quiet {
+		fmt.Println(release.Url)
+		return nil
+	}
+
 	fmt.Printf("%s: %s - %s\n",
-		output.WithBold("GitHub Release"),
+		output.WithBold("%s Release", provider.DisplayName()),
 		release.Name,
 		output.WithHyperlink(release.Url, "View Release"),
 	)
@@ -320,3 +613,369 @@ func runReleaseAction(ctx context.Context, flags *releaseFlags) error {
 
 	return nil
 }
+
+// validateReleaseVersion ensures the version is a valid semantic version (e.g. 1.0.0) before it is used in a release tag.
+// Pre-release versions (e.g. 1.2.3-beta.1) are only accepted when allowPrerelease is set.
+func validateReleaseVersion(version string, allowPrerelease bool) error {
+	parsedVersion, err := semver.Parse(version)
+	if err != nil {
+		return internal.NewUserFriendlyError(
+			fmt.Sprintf("Invalid release version '%s'", version),
+			fmt.Sprintf(
+				"The release version must be a valid semantic version in the form MAJOR.MINOR.PATCH (e.g. 1.0.0): %s",
+				err.Error(),
+			),
+		)
+	}
+
+	if len(parsedVersion.Pre) > 0 && !allowPrerelease {
+		return internal.NewUserFriendlyError(
+			fmt.Sprintf("Pre-release version '%s' is not allowed", version),
+			"Use the --allow-prerelease-version flag to release a pre-release version.",
+		)
+	}
+
+	return nil
+}
+
+// formatCommand renders a command line for display, single quoting any arguments that contain
+// characters the POSIX shell would interpret.
+func formatCommand(command []string) string {
+	parts := make([]string, 0, len(command))
+	for _, arg := range command {
+		parts = append(parts, shellQuote(arg))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// shellQuote wraps an argument in single quotes for the POSIX shell, closing and reopening the quotes
+// around any embedded single quote.
+func shellQuote(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n\"'\\$`!*?[]{}()<>|&;#~") {
+		return arg
+	}
+
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// commitReleaseNotes builds a bulleted list of commit subjects since the most recent tag matching the tag prefix.
+// An empty string is returned when no previous release tag exists.
+func commitReleaseNotes(cwd string, tagPrefix string) (string, error) {
+	/* #nosec G204 - Subprocess launched with a potential tainted input or cmd arguments */
+	describeCmd := exec.Command("git", "describe", "--tags", "--abbrev=0", "--match", tagPrefix+"*")
+	describeCmd.Dir = cwd
+
+	previousTagBytes, err := describeCmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && isNoReleaseTagError(string(exitErr.Stderr)) {
+			// No previous release tag, fall back to the default notes behavior
+			return "", nil
+		}
+
+		return "", fmt.Errorf("failed to find the previous release tag: %w", err)
+	}
+
+	previousTag := strings.TrimSpace(string(previousTagBytes))
+
+	/* #nosec G204 - Subprocess launched with a potential tainted input or cmd arguments */
+	logCmd := exec.Command("git", "log", fmt.Sprintf("%s..HEAD", previousTag), "--pretty=format:%s")
+	logCmd.Dir = cwd
+
+	logBytes, err := logCmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to read commit log since %s: %w, Command output: %s",
+			previousTag, err, string(logBytes),
+		)
+	}
+
+	var notes strings.Builder
+	for _, subject := range strings.Split(string(logBytes), "\n") {
+		subject = strings.TrimSpace(subject)
+		if subject == "" {
+			continue
+		}
+
+		notes.WriteString(fmt.Sprintf("- %s\n", subject))
+	}
+
+	return notes.String(), nil
+}
+
+// isNoReleaseTagError returns true when the git describe output indicates no tag matches the tag prefix
+func isNoReleaseTagError(output string) bool {
+	return strings.Contains(output, "No names found") || strings.Contains(output, "No tags can describe")
+}
+
+// checksumsFileName is the name of the checksum manifest uploaded alongside the release artifacts
+const checksumsFileName = "checksums.txt"
+
+// writeChecksumManifest writes the SHA-256 checksum of each file to the manifest at path using the
+// "<hash>  <filename>" format expected by `sha256sum -c`.
+func writeChecksumManifest(path string, files []string) error {
+	var manifest strings.Builder
+	for _, file := range files {
+		checksum, err := internal.ComputeChecksum(file)
+		if err != nil {
+			return fmt.Errorf("failed to compute checksum for %s: %w", file, err)
+		}
+
+		manifest.WriteString(fmt.Sprintf("%s  %s\n", checksum, filepath.Base(file)))
+	}
+
+	if err := os.WriteFile(path, []byte(manifest.String()), internal.PermissionFile); err != nil {
+		return fmt.Errorf("failed to write checksum manifest: %w", err)
+	}
+
+	return nil
+}
+
+// releaseRetryOptions controls how release creation is retried after transient failures
+type releaseRetryOptions struct {
+	// retries is the number of times to retry after the first attempt
+	retries int
+	// timeout is the maximum duration of all attempts, including the delays between them
+	timeout time.Duration
+	// backoff is the initial delay between attempts, doubled after each retry
+	backoff time.Duration
+}
+
+// createReleaseWithRetry creates the release, retrying with exponential backoff on transient failures.
+// Before each retry the release is looked up so that a release created by a previous attempt, whose
+// follow-up request failed, is treated as success instead of being reported as already existing.
+// A release left behind without all of its assets, or unpublished when a draft was not requested, is an error.
+func createReleaseWithRetry(
+	ctx context.Context,
+	provider releaseProvider,
+	cwd string,
+	tagName string,
+	opts map[string]string,
+	assets []string,
+	retryOptions releaseRetryOptions,
+	progress ux.SetProgressFunc,
+) (*releaseResult, error) {
+	var result *releaseResult
+	attempt := 0
+	backoff := retry.WithMaxRetries(uint64(retryOptions.retries), retry.NewExponential(retryOptions.backoff))
+
+	ctx, cancel := context.WithTimeout(ctx, retryOptions.timeout)
+	defer cancel()
+
+	err := retry.Do(ctx, backoff, func(ctx context.Context) error {
+		attempt++
+		if attempt > 1 {
+			progress(fmt.Sprintf("Retrying (attempt %d of %d)", attempt, retryOptions.retries+1))
+
+			existingRelease, err := provider.ViewRelease(ctx, cwd, opts["repo"], tagName)
+			if err == nil {
+				if err := verifyExistingRelease(existingRelease, opts, assets); err != nil {
+					return err
+				}
+
+				result = existingRelease
+				return nil
+			}
+
+			if !isReleaseNotFoundError(err) {
+				if isTransientReleaseError(err) {
+					return retry.RetryableError(err)
+				}
+
+				return err
+			}
+		}
+
+		createResult, err := provider.CreateRelease(ctx, cwd, tagName, opts, assets)
+		if err != nil {
+			if isTransientReleaseError(err) {
+				return retry.RetryableError(err)
+			}
+
+			return err
+		}
+
+		result = createResult
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, fmt.Errorf("release was not created within the %s timeout: %w", retryOptions.timeout, err)
+		}
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// verifyExistingRelease returns an error when a release created by a previous attempt is incomplete,
+// either because an asset was not uploaded or because it is still a draft when a draft was not requested.
+func verifyExistingRelease(release *releaseResult, opts map[string]string, assets []string) error {
+	var missingAssets []string
+	for _, asset := range assets {
+		if !slices.Contains(release.Assets, filepath.Base(asset)) {
+			missingAssets = append(missingAssets, filepath.Base(asset))
+		}
+	}
+
+	if len(missingAssets) > 0 {
+		return internal.NewUserFriendlyError(
+			"Incomplete release",
+			fmt.Sprintf(
+				"Release %s was created by a previous attempt but is missing assets: %s. "+
+					"Run the release again with --replace to recreate it.",
+				release.TagName, strings.Join(missingAssets, ", "),
+			),
+		)
+	}
+
+	if release.Draft && opts["draft"] != "true" {
+		return internal.NewUserFriendlyError(
+			"Incomplete release",
+			fmt.Sprintf(
+				"Release %s was created by a previous attempt but was not published. "+
+					"Run the release again with --replace to recreate it.",
+				release.TagName,
+			),
+		)
+	}
+
+	return nil
+}
+
+// transientReleaseErrorMarkers are fragments of error output that indicate a failure is likely to succeed on retry
+var transientReleaseErrorMarkers = []string{
+	"http 500",
+	"http 502",
+	"http 503",
+	"http 504",
+	"internal server error",
+	"bad gateway",
+	"service unavailable",
+	"gateway timeout",
+	"connection reset",
+	"connection refused",
+	"i/o timeout",
+	"tls handshake timeout",
+	"unexpected eof",
+}
+
+// isTransientReleaseError returns true when creating the release failed due to a server or network error
+func isTransientReleaseError(err error) bool {
+	if isReleaseAlreadyExistsError(err) {
+		return false
+	}
+
+	message := strings.ToLower(err.Error())
+	for _, marker := range transientReleaseErrorMarkers {
+		if strings.Contains(message, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandArtifactPatterns expands a comma separated list of glob patterns into the distinct set of matching files.
+// Files are returned in the order they are first matched.
+func expandArtifactPatterns(patterns string) ([]string, error) {
+	files := []string{}
+	seen := map[string]struct{}{}
+
+	for _, pattern := range strings.Split(patterns, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid artifact pattern '%s': %w", pattern, err)
+		}
+
+		for _, match := range matches {
+			key := filepath.Clean(match)
+			if _, has := seen[key]; has {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			files = append(files, match)
+		}
+	}
+
+	return files, nil
+}
+
+// checkDuplicateArtifactNames returns an error when two artifacts share the same file name or an artifact
+// uses one of the reserved names, since release assets are uploaded by file name only.
+func checkDuplicateArtifactNames(files []string, reservedNames []string) error {
+	seen := map[string]string{}
+	for _, name := range reservedNames {
+		seen[name] = ""
+	}
+
+	for _, file := range files {
+		name := filepath.Base(file)
+		existing, has := seen[name]
+		if !has {
+			seen[name] = file
+			continue
+		}
+
+		if existing == "" {
+			return fmt.Errorf("artifact %s conflicts with the generated %s file", file, name)
+		}
+
+		return fmt.Errorf("artifacts %s and %s have the same file name %s", existing, file, name)
+	}
+
+	return nil
+}
+
+// validateArtifacts verifies each artifact is a readable file, reporting progress as each file is checked,
+// and returns the total size of all artifacts in bytes.
+func validateArtifacts(files []string, progress ux.SetProgressFunc) (int64, error) {
+	var totalSize int64
+
+	for i, file := range files {
+		progress(fmt.Sprintf("Validating artifact %d of %d: %s", i+1, len(files), filepath.Base(file)))
+
+		fileInfo, err := os.Stat(file)
+		if err != nil {
+			return 0, fmt.Errorf("artifact %s is not accessible: %w", file, err)
+		}
+
+		if fileInfo.IsDir() {
+			return 0, fmt.Errorf("artifact %s is a directory", file)
+		}
+
+		artifactFile, err := os.Open(file)
+		if err != nil {
+			return 0, fmt.Errorf("artifact %s is not readable: %w", file, err)
+		}
+		artifactFile.Close()
+
+		totalSize += fileInfo.Size()
+	}
+
+	return totalSize, nil
+}
+
+// formatFileSize formats a size in bytes using the largest binary unit (e.g. 1.5 MiB)
+func formatFileSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,382 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"strings"
+
+	"github.com/azure/azure-dev/cli/azd/extensions/microsoft.azd.extensions/internal"
+	"github.com/azure/azure-dev/cli/azd/extensions/microsoft.azd.extensions/internal/github"
+	"github.com/azure/azure-dev/cli/azd/extensions/microsoft.azd.extensions/internal/gitlab"
+)
+
+const (
+	releaseProviderGitHub = "github"
+	releaseProviderGitLab = "gitlab"
+)
+
+// releaseRepository is the provider agnostic representation of the repository hosting a release
+type releaseRepository struct {
+	Name string
+	Url  string
+}
+
+// releaseResult is the provider agnostic representation of a created release
+type releaseResult struct {
+	Name    string
+	TagName string
+	Url     string
+	// Draft is true when the release has not been published
+	Draft bool
+	// Assets are the file names of the assets uploaded to the release
+	Assets []string
+}
+
+// releaseProvider creates releases on a source control hosting service
+type releaseProvider interface {
+	// DisplayName returns the user facing name of the provider (e.g. GitHub)
+	DisplayName() string
+	// DefaultHost returns the host the provider CLI uses for repositories specified as owner/repo
+	DefaultHost() string
+	// CheckAndGetInstallError returns a UserFriendlyError when the provider CLI is not installed
+	CheckAndGetInstallError() error
+	// ValidateOptions returns an error when the release options are not supported by the provider
+	ValidateOptions(opts map[string]string) error
+	// ViewRepository gets information about the repository that will host the release
+	ViewRepository(cwd string, repo string) (*releaseRepository, error)
+	// ViewRelease gets information about an existing release
+	ViewRelease(ctx context.Context, cwd string, repo string, tagName string) (*releaseResult, error)
+	// DeleteRelease deletes an existing release and its associated tag
+	DeleteRelease(cwd string, repo string, tagName string) error
+	// TagExists returns true when the tag exists in the repository, whether or not it has a release
+	TagExists(ctx context.Context, cwd string, repo string, tagName string) (bool, error)
+	// DeleteTag deletes a tag that does not have a release
+	DeleteTag(cwd string, repo string, tagName string) error
+	// CreateRelease creates a new release with the specified assets
+	CreateRelease(
+		ctx context.Context,
+		cwd string,
+		tagName string,
+		opts map[string]string,
+		assets []string,
+	) (*releaseResult, error)
+	// CreateReleaseCommand returns the command line that CreateRelease executes
+	CreateReleaseCommand(tagName string, opts map[string]string, assets []string) []string
+}
+
+// newReleaseProvider creates the release provider with the specified name.
+// When name is empty the provider is inferred from the git remote of the extension project.
+func newReleaseProvider(name string, cwd string) (releaseProvider, error) {
+	if name == "" {
+		name = detectReleaseProvider(cwd)
+	}
+
+	switch strings.ToLower(name) {
+	case releaseProviderGitHub:
+		ghCli, err := github.NewGitHubCli()
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize GitHub CLI: %w", err)
+		}
+
+		return &gitHubReleaseProvider{cli: ghCli}, nil
+	case releaseProviderGitLab:
+		glabCli, err := gitlab.NewGitLabCli()
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize GitLab CLI: %w", err)
+		}
+
+		return &gitLabReleaseProvider{cli: glabCli}, nil
+	default:
+		return nil, fmt.Errorf(
+			"unsupported release provider '%s', supported values are '%s' and '%s'",
+			name, releaseProviderGitHub, releaseProviderGitLab,
+		)
+	}
+}
+
+// detectReleaseProvider inspects the host of the origin remote of the git repository and returns the matching
+// provider name. GitLab is used for gitlab.com and hosts starting with "gitlab.", GitHub is used otherwise.
+func detectReleaseProvider(cwd string) string {
+	remoteUrl, err := gitOriginRemoteUrl(cwd)
+	if err != nil {
+		return releaseProviderGitHub
+	}
+
+	host, _, err := parseRemoteUrl(remoteUrl)
+	if err != nil {
+		return releaseProviderGitHub
+	}
+
+	host = strings.ToLower(host)
+	if host == "gitlab.com" || strings.HasPrefix(host, "gitlab.") {
+		return releaseProviderGitLab
+	}
+
+	return releaseProviderGitHub
+}
+
+// gitOriginRemoteUrl returns the URL of the origin remote of the git repository
+func gitOriginRemoteUrl(cwd string) (string, error) {
+	cmd := exec.Command("git", "remote", "get-url", "origin")
+	cmd.Dir = cwd
+
+	remoteBytes, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to get git origin remote: %w", err)
+	}
+
+	return strings.TrimSpace(string(remoteBytes)), nil
+}
+
+// repositoryFromRemoteUrl parses the repository from an SSH (git@host:owner/repo.git),
+// SSH URL (ssh://git@host/owner/repo.git) or HTTPS (https://host/owner/repo.git) git remote URL.
+// The repository is returned as owner/repo when the remote is on the default host and as host/owner/repo otherwise.
+func repositoryFromRemoteUrl(remoteUrl string, defaultHost string) (string, error) {
+	host, repoPath, err := parseRemoteUrl(remoteUrl)
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.EqualFold(host, defaultHost) {
+		return fmt.Sprintf("%s/%s", host, repoPath), nil
+	}
+
+	return repoPath, nil
+}
+
+// parseRemoteUrl splits an SSH, SSH URL or HTTPS git remote URL into its host and owner/repo path
+func parseRemoteUrl(remoteUrl string) (string, string, error) {
+	remoteUrl = strings.TrimSpace(remoteUrl)
+
+	var host string
+	var repoPath string
+	if strings.Contains(remoteUrl, "://") {
+		parsedUrl, err := url.Parse(remoteUrl)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid git remote URL '%s': %w", remoteUrl, err)
+		}
+
+		host = parsedUrl.Hostname()
+		repoPath = parsedUrl.Path
+	} else if userHost, path, has := strings.Cut(remoteUrl, ":"); has {
+		if _, hostName, hasUser := strings.Cut(userHost, "@"); hasUser {
+			userHost = hostName
+		}
+
+		host = userHost
+		repoPath = path
+	}
+
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	if host == "" || !strings.Contains(repoPath, "/") {
+		return "", "", fmt.Errorf("unable to determine repository from git remote URL '%s'", remoteUrl)
+	}
+
+	return host, repoPath, nil
+}
+
+// isReleaseAlreadyExistsError returns true when the error indicates the release already exists on any provider
+func isReleaseAlreadyExistsError(err error) bool {
+	return errors.Is(err, github.ErrReleaseAlreadyExists) || errors.Is(err, gitlab.ErrReleaseAlreadyExists)
+}
+
+// isReleaseNotFoundError returns true when the error indicates the release does not exist on any provider
+func isReleaseNotFoundError(err error) bool {
+	return errors.Is(err, github.ErrReleaseNotFound) || errors.Is(err, gitlab.ErrReleaseNotFound)
+}
+
+// gitHubReleaseProvider creates releases using the GitHub CLI
+type gitHubReleaseProvider struct {
+	cli *github.GitHubCli
+}
+
+func (p *gitHubReleaseProvider) DisplayName() string {
+	return "GitHub"
+}
+
+func (p *gitHubReleaseProvider) DefaultHost() string {
+	return "github.com"
+}
+
+func (p *gitHubReleaseProvider) CheckAndGetInstallError() error {
+	return p.cli.CheckAndGetInstallError()
+}
+
+func (p *gitHubReleaseProvider) ValidateOptions(opts map[string]string) error {
+	return nil
+}
+
+func (p *gitHubReleaseProvider) ViewRepository(cwd string, repo string) (*releaseRepository, error) {
+	repository, err := p.cli.ViewRepository(cwd, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &releaseRepository{Name: repository.Name, Url: repository.Url}, nil
+}
+
+func (p *gitHubReleaseProvider) ViewRelease(
+	ctx context.Context,
+	cwd string,
+	repo string,
+	tagName string,
+) (*releaseResult, error) {
+	release, err := p.cli.ViewRelease(ctx, cwd, repo, tagName)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGitHubReleaseResult(release), nil
+}
+
+func (p *gitHubReleaseProvider) DeleteRelease(cwd string, repo string, tagName string) error {
+	return p.cli.DeleteRelease(cwd, repo, tagName)
+}
+
+func (p *gitHubReleaseProvider) TagExists(ctx context.Context, cwd string, repo string, tagName string) (bool, error) {
+	return p.cli.TagExists(ctx, cwd, repo, tagName)
+}
+
+func (p *gitHubReleaseProvider) DeleteTag(cwd string, repo string, tagName string) error {
+	return p.cli.DeleteTag(cwd, repo, tagName)
+}
+
+func (p *gitHubReleaseProvider) CreateRelease(
+	ctx context.Context,
+	cwd string,
+	tagName string,
+	opts map[string]string,
+	assets []string,
+) (*releaseResult, error) {
+	release, err := p.cli.CreateRelease(ctx, cwd, tagName, opts, assets)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGitHubReleaseResult(release), nil
+}
+
+func (p *gitHubReleaseProvider) CreateReleaseCommand(tagName string, opts map[string]string, assets []string) []string {
+	return append([]string{p.cli.ExecutablePath}, p.cli.CreateReleaseArgs(tagName, opts, assets)...)
+}
+
+// newGitHubReleaseResult converts a GitHub release, including only assets that finished uploading
+func newGitHubReleaseResult(release *github.Release) *releaseResult {
+	result := &releaseResult{
+		Name:    release.Name,
+		TagName: release.TagName,
+		Url:     release.Url,
+		Draft:   release.IsDraft,
+	}
+
+	for _, asset := range release.Assets {
+		if asset.State == "" || strings.EqualFold(asset.State, "uploaded") {
+			result.Assets = append(result.Assets, asset.Name)
+		}
+	}
+
+	return result
+}
+
+// gitLabReleaseProvider creates releases using the GitLab CLI
+type gitLabReleaseProvider struct {
+	cli *gitlab.GitLabCli
+}
+
+func (p *gitLabReleaseProvider) DisplayName() string {
+	return "GitLab"
+}
+
+func (p *gitLabReleaseProvider) DefaultHost() string {
+	return "gitlab.com"
+}
+
+func (p *gitLabReleaseProvider) CheckAndGetInstallError() error {
+	return p.cli.CheckAndGetInstallError()
+}
+
+func (p *gitLabReleaseProvider) ValidateOptions(opts map[string]string) error {
+	if err := p.cli.ValidateReleaseOptions(opts); err != nil {
+		return internal.NewUserFriendlyError(
+			"Release options not supported by GitLab",
+			fmt.Sprintf("GitLab releases do not have pre-release or draft states (%s).", err.Error()),
+		)
+	}
+
+	return nil
+}
+
+func (p *gitLabReleaseProvider) ViewRepository(cwd string, repo string) (*releaseRepository, error) {
+	repository, err := p.cli.ViewRepository(cwd, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &releaseRepository{Name: repository.Name, Url: repository.Url}, nil
+}
+
+func (p *gitLabReleaseProvider) ViewRelease(
+	ctx context.Context,
+	cwd string,
+	repo string,
+	tagName string,
+) (*releaseResult, error) {
+	release, err := p.cli.ViewRelease(ctx, cwd, repo, tagName)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGitLabReleaseResult(release), nil
+}
+
+func (p *gitLabReleaseProvider) DeleteRelease(cwd string, repo string, tagName string) error {
+	return p.cli.DeleteRelease(cwd, repo, tagName)
+}
+
+func (p *gitLabReleaseProvider) TagExists(ctx context.Context, cwd string, repo string, tagName string) (bool, error) {
+	return p.cli.TagExists(ctx, cwd, repo, tagName)
+}
+
+func (p *gitLabReleaseProvider) DeleteTag(cwd string, repo string, tagName string) error {
+	return p.cli.DeleteTag(cwd, repo, tagName)
+}
+
+func (p *gitLabReleaseProvider) CreateRelease(
+	ctx context.Context,
+	cwd string,
+	tagName string,
+	opts map[string]string,
+	assets []string,
+) (*releaseResult, error) {
+	release, err := p.cli.CreateRelease(ctx, cwd, tagName, opts, assets)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGitLabReleaseResult(release), nil
+}
+
+func (p *gitLabReleaseProvider) CreateReleaseCommand(tagName string, opts map[string]string, assets []string) []string {
+	return append([]string{p.cli.ExecutablePath}, p.cli.CreateReleaseArgs(tagName, opts, assets)...)
+}
+
+// newGitLabReleaseResult converts a GitLab release. GitLab releases do not have a draft state.
+func newGitLabReleaseResult(release *gitlab.Release) *releaseResult {
+	result := &releaseResult{
+		Name:    release.Name,
+		TagName: release.TagName,
+		Url:     release.Links.Self,
+	}
+
+	for _, link := range release.Assets.Links {
+		result.Assets = append(result.Assets, link.Name)
+	}
+
+	return result
+}
@@ -0,0 +1,672 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/azure/azure-dev/cli/azd/extensions/microsoft.azd.extensions/internal"
+	"github.com/azure/azure-dev/cli/azd/extensions/microsoft.azd.extensions/internal/github"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWriteChecksumManifest verifies the manifest contains one "<hash>  <filename>" line per artifact
+func TestWriteChecksumManifest(t *testing.T) {
+	tempDir := t.TempDir()
+
+	fixtures := map[string]string{
+		"ext-linux-amd64.zip":   "linux artifact",
+		"ext-windows-amd64.zip": "windows artifact",
+	}
+
+	files := []string{}
+	expected := ""
+	for _, name := range []string{"ext-linux-amd64.zip", "ext-windows-amd64.zip"} {
+		path := filepath.Join(tempDir, name)
+		require.NoError(t, os.WriteFile(path, []byte(fixtures[name]), 0600))
+		files = append(files, path)
+
+		hash := sha256.Sum256([]byte(fixtures[name]))
+		expected += hex.EncodeToString(hash[:]) + "  " + name + "\n"
+	}
+
+	manifestPath := filepath.Join(tempDir, checksumsFileName)
+	require.NoError(t, writeChecksumManifest(manifestPath, files))
+
+	manifest, err := os.ReadFile(manifestPath)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(manifest))
+}
+
+// TestWriteChecksumManifest_MissingFile verifies an error is returned when an artifact cannot be read
+func TestWriteChecksumManifest_MissingFile(t *testing.T) {
+	tempDir := t.TempDir()
+
+	err := writeChecksumManifest(
+		filepath.Join(tempDir, checksumsFileName),
+		[]string{filepath.Join(tempDir, "missing.zip")},
+	)
+	require.Error(t, err)
+}
+
+// TestIsTransientReleaseError verifies only server and network failures are retried
+func TestIsTransientReleaseError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "ServerError",
+			err:      errors.New("failed to run command: exit status 1, Command output: HTTP 502: Bad Gateway"),
+			expected: true,
+		},
+		{
+			name:     "NetworkError",
+			err:      errors.New("failed to run command: exit status 1, Command output: read: connection reset by peer"),
+			expected: true,
+		},
+		{
+			name:     "ReleaseAlreadyExists",
+			err:      fmt.Errorf("HTTP 502, %w", github.ErrReleaseAlreadyExists),
+			expected: false,
+		},
+		{
+			name:     "NotFound",
+			err:      errors.New("failed to run command: exit status 1, Command output: HTTP 404: Not Found"),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, isTransientReleaseError(tt.err))
+		})
+	}
+}
+
+// TestExpandArtifactPatterns verifies multiple glob patterns are expanded into a de-duplicated file list
+func TestExpandArtifactPatterns(t *testing.T) {
+	tempDir := t.TempDir()
+
+	for _, name := range []string{"ext-linux-amd64.zip", "ext-windows-amd64.zip", "manifest.json", "README.md"} {
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte(name), 0600))
+	}
+
+	t.Run("MultiplePatterns", func(t *testing.T) {
+		files, err := expandArtifactPatterns(
+			filepath.Join(tempDir, "*.zip") + "," + filepath.Join(tempDir, "manifest.json"),
+		)
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			filepath.Join(tempDir, "ext-linux-amd64.zip"),
+			filepath.Join(tempDir, "ext-windows-amd64.zip"),
+			filepath.Join(tempDir, "manifest.json"),
+		}, files)
+	})
+
+	t.Run("OverlappingPatterns", func(t *testing.T) {
+		patterns := []string{
+			filepath.Join(tempDir, "*.zip"),
+			filepath.Join(tempDir, "ext-linux-*"),
+			filepath.Join(tempDir, "*"),
+		}
+
+		files, err := expandArtifactPatterns(strings.Join(patterns, ", "))
+		require.NoError(t, err)
+		require.Len(t, files, 4)
+		require.Equal(t, filepath.Join(tempDir, "ext-linux-amd64.zip"), files[0])
+		require.Equal(t, filepath.Join(tempDir, "ext-windows-amd64.zip"), files[1])
+	})
+
+	t.Run("NoMatches", func(t *testing.T) {
+		files, err := expandArtifactPatterns(filepath.Join(tempDir, "*.tar.gz"))
+		require.NoError(t, err)
+		require.Empty(t, files)
+	})
+}
+
+// TestRepositoryFromRemoteUrl verifies the repository is parsed from SSH and HTTPS git remote URLs
+func TestRepositoryFromRemoteUrl(t *testing.T) {
+	tests := []struct {
+		name        string
+		remoteUrl   string
+		defaultHost string
+		expected    string
+	}{
+		{
+			name:        "HTTPS",
+			remoteUrl:   "https://github.com/Azure/azure-dev.git",
+			defaultHost: "github.com",
+			expected:    "Azure/azure-dev",
+		},
+		{
+			name:        "HTTPSWithoutSuffix",
+			remoteUrl:   "https://github.com/Azure/azure-dev",
+			defaultHost: "github.com",
+			expected:    "Azure/azure-dev",
+		},
+		{
+			name:        "HTTPSWithUser",
+			remoteUrl:   "https://user@github.com/Azure/azure-dev.git/",
+			defaultHost: "github.com",
+			expected:    "Azure/azure-dev",
+		},
+		{
+			name:        "SSH",
+			remoteUrl:   "git@github.com:Azure/azure-dev.git",
+			defaultHost: "github.com",
+			expected:    "Azure/azure-dev",
+		},
+		{
+			name:        "SSHUrl",
+			remoteUrl:   "ssh://git@github.com:22/Azure/azure-dev.git",
+			defaultHost: "github.com",
+			expected:    "Azure/azure-dev",
+		},
+		{
+			name:        "GitLabSubgroup",
+			remoteUrl:   "git@gitlab.com:group/subgroup/project.git",
+			defaultHost: "gitlab.com",
+			expected:    "group/subgroup/project",
+		},
+		{
+			name:        "GitHubEnterprise",
+			remoteUrl:   "https://github.contoso.com/org/extension.git",
+			defaultHost: "github.com",
+			expected:    "github.contoso.com/org/extension",
+		},
+		{
+			name:        "SelfHostedGitLab",
+			remoteUrl:   "git@gitlab.contoso.com:group/project.git",
+			defaultHost: "gitlab.com",
+			expected:    "gitlab.contoso.com/group/project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, err := repositoryFromRemoteUrl(tt.remoteUrl, tt.defaultHost)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, repo)
+		})
+	}
+
+	t.Run("LocalPath", func(t *testing.T) {
+		_, err := repositoryFromRemoteUrl("/path/to/local/repo-only", "github.com")
+		require.Error(t, err)
+	})
+
+	t.Run("FileUrl", func(t *testing.T) {
+		_, err := repositoryFromRemoteUrl("file:///srv/git/owner/repo.git", "github.com")
+		require.Error(t, err)
+	})
+}
+
+// initGitRepo creates a git repository in a temp directory for tests that shell out to git
+func initGitRepo(t *testing.T) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repoDir := t.TempDir()
+	runGit(t, repoDir, "init", "--quiet")
+	runGit(t, repoDir, "config", "user.name", "Test")
+	runGit(t, repoDir, "config", "user.email", "test@example.com")
+	runGit(t, repoDir, "config", "commit.gpgsign", "false")
+	runGit(t, repoDir, "config", "tag.gpgsign", "false")
+
+	return repoDir
+}
+
+// runGit runs a git command in the specified directory and fails the test on error
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+func TestDetectReleaseProvider(t *testing.T) {
+	tests := []struct {
+		name      string
+		remoteUrl string
+		expected  string
+	}{
+		{name: "GitHubHttps", remoteUrl: "https://github.com/owner/repo.git", expected: releaseProviderGitHub},
+		{name: "GitHubSsh", remoteUrl: "git@github.com:owner/repo.git", expected: releaseProviderGitHub},
+		{name: "GitLabHttps", remoteUrl: "https://gitlab.com/group/project.git", expected: releaseProviderGitLab},
+		{name: "GitLabSsh", remoteUrl: "git@gitlab.com:group/subgroup/project.git", expected: releaseProviderGitLab},
+		{
+			name:      "SelfHostedGitLab",
+			remoteUrl: "https://gitlab.contoso.com/group/project.git",
+			expected:  releaseProviderGitLab,
+		},
+		{
+			name:      "GitHubRepoNamedGitLab",
+			remoteUrl: "https://github.com/acme/gitlab-sync.git",
+			expected:  releaseProviderGitHub,
+		},
+		{
+			name:      "GitHubOwnerNamedGitLab",
+			remoteUrl: "git@github.com:gitlab-org/mirror.git",
+			expected:  releaseProviderGitHub,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoDir := initGitRepo(t)
+			runGit(t, repoDir, "remote", "add", "origin", tt.remoteUrl)
+
+			require.Equal(t, tt.expected, detectReleaseProvider(repoDir))
+		})
+	}
+
+	t.Run("NoRemote", func(t *testing.T) {
+		require.Equal(t, releaseProviderGitHub, detectReleaseProvider(initGitRepo(t)))
+	})
+}
+
+func TestCheckDuplicateArtifactNames(t *testing.T) {
+	t.Run("UniqueNames", func(t *testing.T) {
+		files := []string{filepath.Join("linux", "ext-linux.tar.gz"), filepath.Join("windows", "ext-windows.zip")}
+		require.NoError(t, checkDuplicateArtifactNames(files, []string{checksumsFileName}))
+	})
+
+	t.Run("SameBaseName", func(t *testing.T) {
+		files := []string{filepath.Join("amd64", "ext.zip"), filepath.Join("arm64", "ext.zip")}
+
+		err := checkDuplicateArtifactNames(files, []string{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "ext.zip")
+	})
+
+	t.Run("ReservedName", func(t *testing.T) {
+		files := []string{filepath.Join("dist", checksumsFileName)}
+
+		err := checkDuplicateArtifactNames(files, []string{checksumsFileName})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), checksumsFileName)
+	})
+
+	t.Run("ReservedNameNotUsed", func(t *testing.T) {
+		files := []string{filepath.Join("dist", checksumsFileName)}
+		require.NoError(t, checkDuplicateArtifactNames(files, []string{}))
+	})
+}
+
+func TestFormatCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  []string
+		expected string
+	}{
+		{name: "Plain", command: []string{"gh", "release", "create", "v1.0.0"}, expected: "gh release create v1.0.0"},
+		{name: "Whitespace", command: []string{"gh", "--title", "My Release"}, expected: "gh --title 'My Release'"},
+		{name: "Empty", command: []string{"gh", "--notes", ""}, expected: "gh --notes ''"},
+		{name: "SingleQuote", command: []string{"gh", "--notes", "it's done"}, expected: `gh --notes 'it'\''s done'`},
+		{name: "DoubleQuote", command: []string{"gh", "--notes", `say "hi"`}, expected: `gh --notes 'say "hi"'`},
+		{name: "ShellExpansion", command: []string{"gh", "--notes", "$HOME"}, expected: "gh --notes '$HOME'"},
+		{name: "Newline", command: []string{"gh", "--notes", "a\nb"}, expected: "gh --notes 'a\nb'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, formatCommand(tt.command))
+		})
+	}
+}
+
+func TestCommitReleaseNotes(t *testing.T) {
+	commit := func(t *testing.T, repoDir string, subject string) {
+		runGit(t, repoDir, "commit", "--quiet", "--allow-empty", "-m", subject)
+	}
+
+	t.Run("NoPreviousTag", func(t *testing.T) {
+		repoDir := initGitRepo(t)
+		commit(t, repoDir, "Initial commit")
+
+		notes, err := commitReleaseNotes(repoDir, "azd-ext-test_")
+		require.NoError(t, err)
+		require.Empty(t, notes)
+	})
+
+	t.Run("CommitsSinceTag", func(t *testing.T) {
+		repoDir := initGitRepo(t)
+		commit(t, repoDir, "Initial commit")
+		runGit(t, repoDir, "tag", "azd-ext-test_1.0.0")
+		commit(t, repoDir, "Add feature")
+		commit(t, repoDir, "Fix bug")
+
+		notes, err := commitReleaseNotes(repoDir, "azd-ext-test_")
+		require.NoError(t, err)
+		require.Equal(t, "- Fix bug\n- Add feature\n", notes)
+	})
+
+	t.Run("OtherPrefixIgnored", func(t *testing.T) {
+		repoDir := initGitRepo(t)
+		commit(t, repoDir, "Initial commit")
+		runGit(t, repoDir, "tag", "azd-ext-test_1.0.0")
+		commit(t, repoDir, "Add feature")
+		runGit(t, repoDir, "tag", "azd-ext-other_1.0.0")
+		commit(t, repoDir, "Fix bug")
+
+		notes, err := commitReleaseNotes(repoDir, "azd-ext-test_")
+		require.NoError(t, err)
+		require.Equal(t, "- Fix bug\n- Add feature\n", notes)
+	})
+
+	t.Run("OnlyOtherPrefix", func(t *testing.T) {
+		repoDir := initGitRepo(t)
+		commit(t, repoDir, "Initial commit")
+		runGit(t, repoDir, "tag", "azd-ext-other_1.0.0")
+
+		notes, err := commitReleaseNotes(repoDir, "azd-ext-test_")
+		require.NoError(t, err)
+		require.Empty(t, notes)
+	})
+
+	t.Run("GitFailure", func(t *testing.T) {
+		repoDir := initGitRepo(t)
+
+		_, err := commitReleaseNotes(filepath.Join(repoDir, "missing"), "azd-ext-test_")
+		require.Error(t, err)
+	})
+}
+
+func TestValidateReleaseVersion(t *testing.T) {
+	tests := []struct {
+		name            string
+		version         string
+		allowPrerelease bool
+		expectError     bool
+	}{
+		{name: "Valid", version: "1.0.0", expectError: false},
+		{name: "VersionPrefix", version: "v1.0", expectError: true},
+		{name: "MissingPatch", version: "1.0", expectError: true},
+		{name: "Empty", version: "", expectError: true},
+		{name: "PrereleaseNotAllowed", version: "1.2.3-beta.1", allowPrerelease: false, expectError: true},
+		{name: "PrereleaseAllowed", version: "1.2.3-beta.1", allowPrerelease: true, expectError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateReleaseVersion(tt.version, tt.allowPrerelease)
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// TestValidateArtifacts verifies progress is reported for each artifact and the total size is returned
+func TestValidateArtifacts(t *testing.T) {
+	tempDir := t.TempDir()
+
+	files := []string{}
+	for i, name := range []string{"ext-darwin-arm64.zip", "ext-linux-amd64.zip", "ext-windows-amd64.zip"} {
+		path := filepath.Join(tempDir, name)
+		require.NoError(t, os.WriteFile(path, make([]byte, (i+1)*1024), 0600))
+		files = append(files, path)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		progress := []string{}
+		totalSize, err := validateArtifacts(files, func(message string) {
+			progress = append(progress, message)
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(6*1024), totalSize)
+		require.Equal(t, []string{
+			"Validating artifact 1 of 3: ext-darwin-arm64.zip",
+			"Validating artifact 2 of 3: ext-linux-amd64.zip",
+			"Validating artifact 3 of 3: ext-windows-amd64.zip",
+		}, progress)
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		missingFile := filepath.Join(tempDir, "missing.zip")
+		_, err := validateArtifacts(append(files, missingFile), func(string) {})
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), missingFile)
+	})
+
+	t.Run("Directory", func(t *testing.T) {
+		_, err := validateArtifacts([]string{tempDir}, func(string) {})
+		require.Error(t, err)
+	})
+}
+
+// TestFormatFileSize verifies sizes are formatted using binary units
+func TestFormatFileSize(t *testing.T) {
+	require.Equal(t, "512 B", formatFileSize(512))
+	require.Equal(t, "1.5 KiB", formatFileSize(1536))
+	require.Equal(t, "2.0 MiB", formatFileSize(2*1024*1024))
+}
+
+// fakeReleaseProvider is a releaseProvider that returns scripted results for CreateRelease and ViewRelease
+type fakeReleaseProvider struct {
+	createErrors    []error
+	viewErrors      []error
+	existingRelease *releaseResult
+	createCalls     int
+	viewCalls       int
+}
+
+func (p *fakeReleaseProvider) DisplayName() string {
+	return "Fake"
+}
+
+func (p *fakeReleaseProvider) DefaultHost() string {
+	return "example.com"
+}
+
+func (p *fakeReleaseProvider) CheckAndGetInstallError() error {
+	return nil
+}
+
+func (p *fakeReleaseProvider) ValidateOptions(opts map[string]string) error {
+	return nil
+}
+
+func (p *fakeReleaseProvider) ViewRepository(cwd string, repo string) (*releaseRepository, error) {
+	return &releaseRepository{Name: repo}, nil
+}
+
+func (p *fakeReleaseProvider) ViewRelease(
+	ctx context.Context,
+	cwd string,
+	repo string,
+	tagName string,
+) (*releaseResult, error) {
+	p.viewCalls++
+	if len(p.viewErrors) >= p.viewCalls && p.viewErrors[p.viewCalls-1] != nil {
+		return nil, p.viewErrors[p.viewCalls-1]
+	}
+
+	if p.existingRelease != nil {
+		return p.existingRelease, nil
+	}
+
+	return &releaseResult{Name: "existing", TagName: tagName}, nil
+}
+
+func (p *fakeReleaseProvider) DeleteRelease(cwd string, repo string, tagName string) error {
+	return nil
+}
+
+func (p *fakeReleaseProvider) TagExists(ctx context.Context, cwd string, repo string, tagName string) (bool, error) {
+	return false, nil
+}
+
+func (p *fakeReleaseProvider) DeleteTag(cwd string, repo string, tagName string) error {
+	return nil
+}
+
+func (p *fakeReleaseProvider) CreateRelease(
+	ctx context.Context,
+	cwd string,
+	tagName string,
+	opts map[string]string,
+	assets []string,
+) (*releaseResult, error) {
+	p.createCalls++
+	if len(p.createErrors) >= p.createCalls && p.createErrors[p.createCalls-1] != nil {
+		return nil, p.createErrors[p.createCalls-1]
+	}
+
+	return &releaseResult{Name: "created", TagName: tagName}, nil
+}
+
+func (p *fakeReleaseProvider) CreateReleaseCommand(tagName string, opts map[string]string, assets []string) []string {
+	return []string{"fake", "release", "create", tagName}
+}
+
+// TestCreateReleaseWithRetry verifies release creation retries transient failures without recreating
+// a release that was already created by a previous attempt
+func TestCreateReleaseWithRetry(t *testing.T) {
+	transientErr := errors.New("failed to run command: exit status 1, Command output: HTTP 502: Bad Gateway")
+	notFoundErr := fmt.Errorf("release not found, %w", github.ErrReleaseNotFound)
+	retryOptions := releaseRetryOptions{retries: 2, timeout: time.Minute, backoff: time.Millisecond}
+
+	assets := []string{filepath.Join("dist", "ext-linux.tar.gz"), filepath.Join("dist", "ext-windows.zip")}
+	createRelease := func(provider *fakeReleaseProvider) (*releaseResult, error) {
+		return createReleaseWithRetry(
+			context.Background(), provider, t.TempDir(), "v1.0.0", map[string]string{}, assets,
+			retryOptions, func(string) {},
+		)
+	}
+
+	t.Run("SuccessFirstAttempt", func(t *testing.T) {
+		provider := &fakeReleaseProvider{}
+		result, err := createRelease(provider)
+
+		require.NoError(t, err)
+		require.Equal(t, "created", result.Name)
+		require.Equal(t, 1, provider.createCalls)
+		require.Equal(t, 0, provider.viewCalls)
+	})
+
+	t.Run("RetriesTransientFailure", func(t *testing.T) {
+		provider := &fakeReleaseProvider{
+			createErrors: []error{transientErr},
+			viewErrors:   []error{notFoundErr},
+		}
+		result, err := createRelease(provider)
+
+		require.NoError(t, err)
+		require.Equal(t, "created", result.Name)
+		require.Equal(t, 2, provider.createCalls)
+	})
+
+	t.Run("ReleaseCreatedByPreviousAttempt", func(t *testing.T) {
+		// The release was created but the follow-up request failed with a transient error
+		provider := &fakeReleaseProvider{
+			createErrors: []error{transientErr},
+			existingRelease: &releaseResult{
+				Name:    "existing",
+				TagName: "v1.0.0",
+				Assets:  []string{"ext-linux.tar.gz", "ext-windows.zip"},
+			},
+		}
+		result, err := createRelease(provider)
+
+		require.NoError(t, err)
+		require.Equal(t, "existing", result.Name)
+		require.Equal(t, 1, provider.createCalls)
+		require.Equal(t, 1, provider.viewCalls)
+	})
+
+	t.Run("PreviousAttemptMissingAssets", func(t *testing.T) {
+		// The release was created but an asset upload failed with a transient error
+		provider := &fakeReleaseProvider{
+			createErrors: []error{transientErr},
+			existingRelease: &releaseResult{
+				Name:    "existing",
+				TagName: "v1.0.0",
+				Assets:  []string{"ext-linux.tar.gz"},
+			},
+		}
+		_, err := createRelease(provider)
+
+		var userErr *internal.UserFriendlyError
+		require.ErrorAs(t, err, &userErr)
+		require.Contains(t, userErr.GetUserDetails(), "ext-windows.zip")
+		require.Equal(t, 1, provider.createCalls)
+	})
+
+	t.Run("PreviousAttemptNotPublished", func(t *testing.T) {
+		provider := &fakeReleaseProvider{
+			createErrors: []error{transientErr},
+			existingRelease: &releaseResult{
+				Name:    "existing",
+				TagName: "v1.0.0",
+				Draft:   true,
+				Assets:  []string{"ext-linux.tar.gz", "ext-windows.zip"},
+			},
+		}
+		_, err := createRelease(provider)
+
+		var userErr *internal.UserFriendlyError
+		require.ErrorAs(t, err, &userErr)
+		require.Contains(t, userErr.GetUserDetails(), "not published")
+		require.Equal(t, 1, provider.createCalls)
+	})
+
+	t.Run("NonTransientFailure", func(t *testing.T) {
+		provider := &fakeReleaseProvider{createErrors: []error{errors.New("HTTP 422: Validation Failed")}}
+		_, err := createRelease(provider)
+
+		require.Error(t, err)
+		require.Equal(t, 1, provider.createCalls)
+	})
+
+	t.Run("TimeoutIncludesRetries", func(t *testing.T) {
+		provider := &fakeReleaseProvider{}
+		for range 100 {
+			provider.createErrors = append(provider.createErrors, transientErr)
+			provider.viewErrors = append(provider.viewErrors, notFoundErr)
+		}
+
+		_, err := createReleaseWithRetry(
+			context.Background(), provider, t.TempDir(), "v1.0.0", map[string]string{}, []string{},
+			releaseRetryOptions{retries: 100, timeout: 100 * time.Millisecond, backoff: 20 * time.Millisecond},
+			func(string) {},
+		)
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Less(t, provider.createCalls, 100)
+	})
+
+	t.Run("RetriesExhausted", func(t *testing.T) {
+		provider := &fakeReleaseProvider{
+			createErrors: []error{transientErr, transientErr, transientErr},
+			viewErrors:   []error{notFoundErr, notFoundErr},
+		}
+		_, err := createRelease(provider)
+
+		require.ErrorIs(t, err, transientErr)
+		require.Equal(t, 3, provider.createCalls)
+	})
+}
@@ -4,10 +4,13 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os/exec"
 	"runtime"
+	"slices"
 	"strings"
 
 	"github.com/azure/azure-dev/cli/azd/extensions/microsoft.azd.extensions/internal"
@@ -39,6 +42,7 @@ type Release struct {
 	Name    string          `json:"name"`
 	TagName string          `json:"tagName"`
 	Url     string          `json:"url"`
+	IsDraft bool            `json:"isDraft"`
 	Assets  []*ReleaseAsset `json:"assets"`
 }
 
@@ -132,20 +136,24 @@ func (gh *GitHubCli) ViewRepository(cwd string, repo string) (*Repository, error
 }
 
 // ViewRelease gets information about a GitHub release
-func (gh *GitHubCli) ViewRelease(cwd string, repo string, tagName string) (*Release, error) {
+func (gh *GitHubCli) ViewRelease(ctx context.Context, cwd string, repo string, tagName string) (*Release, error) {
 	args := []string{"release", "view", tagName}
 	if repo != "" {
 		args = append(args, "--repo", repo)
 	}
 
-	args = append(args, "--json", "name,tagName,url,assets")
+	args = append(args, "--json", "name,tagName,url,isDraft,assets")
 
 	/* #nosec G204 - Subprocess launched with a potential tainted input or cmd arguments */
-	cmd := exec.Command(gh.ExecutablePath, args...)
+	cmd := exec.CommandContext(ctx, gh.ExecutablePath, args...)
 	cmd.Dir = cwd
 
 	resultBytes, err := cmd.CombinedOutput()
 	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("release view command did not complete: %w", ctx.Err())
+		}
+
 		errorMessage := string(resultBytes)
 		if strings.Contains(errorMessage, "release not found") {
 			return nil, fmt.Errorf("%s, %w", errorMessage, ErrReleaseNotFound)
@@ -162,19 +170,21 @@ func (gh *GitHubCli) ViewRelease(cwd string, repo string, tagName string) (*Rele
 	return releaseResult, nil
 }
 
-// CreateRelease creates a new GitHub release
-func (gh *GitHubCli) CreateRelease(cwd string, tagName string, opts map[string]string, assets []string) (*Release, error) {
+// CreateReleaseArgs returns the GitHub CLI arguments used to create a release
+func (gh *GitHubCli) CreateReleaseArgs(tagName string, opts map[string]string, assets []string) []string {
 	args := []string{"release", "create", tagName}
+	booleanFlags := []string{"prerelease", "draft"}
 
-	// Add optional arguments
-	for key, value := range opts {
-		if value != "" {
+	// Add optional arguments in a stable order
+	keys := slices.Sorted(maps.Keys(opts))
+	for _, key := range keys {
+		if value := opts[key]; value != "" && !slices.Contains(booleanFlags, key) {
 			args = append(args, fmt.Sprintf("--%s", key), value)
 		}
 	}
 
 	// Add boolean flags
-	for _, flag := range []string{"prerelease", "draft"} {
+	for _, flag := range booleanFlags {
 		if value, ok := opts[flag]; ok && value == "true" {
 			args = append(args, fmt.Sprintf("--%s", flag))
 		}
@@ -183,13 +193,31 @@ func (gh *GitHubCli) CreateRelease(cwd string, tagName string, opts map[string]s
 	// Add assets
 	args = append(args, assets...)
 
+	return args
+}
+
+// CreateRelease creates a new GitHub release.
+// The GitHub CLI process is killed if the context is cancelled before the release is created.
+func (gh *GitHubCli) CreateRelease(
+	ctx context.Context,
+	cwd string,
+	tagName string,
+	opts map[string]string,
+	assets []string,
+) (*Release, error) {
+	args := gh.CreateReleaseArgs(tagName, opts, assets)
+
 	// First create the release
 	/* #nosec G204 - Subprocess launched with a potential tainted input or cmd arguments */
-	cmd := exec.Command(gh.ExecutablePath, args...)
+	cmd := exec.CommandContext(ctx, gh.ExecutablePath, args...)
 	cmd.Dir = cwd
 
 	resultBytes, err := cmd.CombinedOutput()
 	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("release command did not complete: %w", ctx.Err())
+		}
+
 		errorMessage := string(resultBytes)
 		if strings.Contains(errorMessage, "a release with the same tag name already exists") {
 			return nil, fmt.Errorf("%s, %w", errorMessage, ErrReleaseAlreadyExists)
@@ -199,7 +227,91 @@ func (gh *GitHubCli) CreateRelease(cwd string, tagName string, opts map[string]s
 	}
 
 	// Then fetch the created release details to return a full Release object
-	return gh.ViewRelease(cwd, opts["repo"], tagName)
+	return gh.ViewRelease(ctx, cwd, opts["repo"], tagName)
+}
+
+// DeleteRelease deletes a GitHub release and its associated tag
+func (gh *GitHubCli) DeleteRelease(cwd string, repo string, tagName string) error {
+	args := []string{"release", "delete", tagName, "--yes", "--cleanup-tag"}
+	if repo != "" {
+		args = append(args, "--repo", repo)
+	}
+
+	/* #nosec G204 - Subprocess launched with a potential tainted input or cmd arguments */
+	cmd := exec.Command(gh.ExecutablePath, args...)
+	cmd.Dir = cwd
+
+	resultBytes, err := cmd.CombinedOutput()
+	if err != nil {
+		errorMessage := string(resultBytes)
+		if strings.Contains(errorMessage, "release not found") {
+			return fmt.Errorf("%s, %w", errorMessage, ErrReleaseNotFound)
+		}
+
+		return fmt.Errorf("failed to run command: %w, Command output: %s", err, errorMessage)
+	}
+
+	return nil
+}
+
+// TagExists returns true when the tag exists in the GitHub repository, whether or not it has a release
+func (gh *GitHubCli) TagExists(ctx context.Context, cwd string, repo string, tagName string) (bool, error) {
+	args := repositoryApiArgs(repo, fmt.Sprintf("git/ref/tags/%s", tagName))
+
+	/* #nosec G204 - Subprocess launched with a potential tainted input or cmd arguments */
+	cmd := exec.CommandContext(ctx, gh.ExecutablePath, args...)
+	cmd.Dir = cwd
+
+	resultBytes, err := cmd.CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, fmt.Errorf("tag lookup command did not complete: %w", ctx.Err())
+		}
+
+		errorMessage := string(resultBytes)
+		if strings.Contains(errorMessage, "HTTP 404") {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to run command: %w, Command output: %s", err, errorMessage)
+	}
+
+	return true, nil
+}
+
+// DeleteTag deletes a tag from the GitHub repository
+func (gh *GitHubCli) DeleteTag(cwd string, repo string, tagName string) error {
+	args := repositoryApiArgs(repo, fmt.Sprintf("git/refs/tags/%s", tagName))
+	args = append(args, "--method", "DELETE")
+
+	/* #nosec G204 - Subprocess launched with a potential tainted input or cmd arguments */
+	cmd := exec.Command(gh.ExecutablePath, args...)
+	cmd.Dir = cwd
+
+	resultBytes, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to run command: %w, Command output: %s", err, string(resultBytes))
+	}
+
+	return nil
+}
+
+// repositoryApiArgs returns the GitHub CLI api arguments for an endpoint of the repository.
+// The repository may be specified as [HOST/]OWNER/REPO. When repo is empty the GitHub CLI
+// resolves the repository of the current directory.
+func repositoryApiArgs(repo string, endpoint string) []string {
+	args := []string{"api"}
+	repoPath := "{owner}/{repo}"
+
+	if repo != "" {
+		repoPath = repo
+		if host, path, has := strings.Cut(repo, "/"); has && strings.Count(path, "/") == 1 {
+			args = append(args, "--hostname", host)
+			repoPath = path
+		}
+	}
+
+	return append(args, fmt.Sprintf("repos/%s/%s", repoPath, endpoint))
 }
 
 // GetInstallInstructions returns OS-specific instructions for installing GitHub CLI (legacy method)
@@ -0,0 +1,167 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package github
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateReleaseArgs(t *testing.T) {
+	ghCli := &GitHubCli{ExecutablePath: "gh"}
+
+	t.Run("SortedOptions", func(t *testing.T) {
+		args := ghCli.CreateReleaseArgs(
+			"v1.0.0",
+			map[string]string{
+				"title": "Release 1.0.0",
+				"repo":  "owner/repo",
+				"notes": "Initial release",
+			},
+			[]string{"a.zip", "b.tar.gz"},
+		)
+
+		require.Equal(t, []string{
+			"release", "create", "v1.0.0",
+			"--notes", "Initial release",
+			"--repo", "owner/repo",
+			"--title", "Release 1.0.0",
+			"a.zip", "b.tar.gz",
+		}, args)
+	})
+
+	t.Run("BooleanFlags", func(t *testing.T) {
+		args := ghCli.CreateReleaseArgs(
+			"v1.0.0",
+			map[string]string{"draft": "true", "prerelease": "true", "title": "Release"},
+			[]string{"a.zip"},
+		)
+
+		require.Equal(t, []string{
+			"release", "create", "v1.0.0",
+			"--title", "Release",
+			"--prerelease", "--draft",
+			"a.zip",
+		}, args)
+	})
+
+	t.Run("FalseAndEmptyOmitted", func(t *testing.T) {
+		args := ghCli.CreateReleaseArgs(
+			"v1.0.0",
+			map[string]string{"draft": "false", "prerelease": "false", "notes": ""},
+			[]string{},
+		)
+
+		require.Equal(t, []string{"release", "create", "v1.0.0"}, args)
+	})
+}
+
+// TestCreateRelease_Timeout verifies a hung GitHub CLI process is killed when the context deadline is exceeded
+func TestCreateRelease_Timeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake GitHub CLI is implemented as a shell script")
+	}
+
+	fakeGhPath := filepath.Join(t.TempDir(), "gh")
+	//nolint:gosec // the fake CLI must be executable
+	require.NoError(t, os.WriteFile(fakeGhPath, []byte("#!/bin/sh\nexec sleep 30\n"), 0700))
+
+	ghCli := &GitHubCli{ExecutablePath: fakeGhPath}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	release, err := ghCli.CreateRelease(ctx, t.TempDir(), "v1.0.0", map[string]string{}, []string{})
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Nil(t, release)
+	require.Less(t, time.Since(start), 10*time.Second)
+}
+
+// TestCreateRelease_ViewTimeout verifies a hung GitHub CLI process looking up the created release is killed
+// when the context deadline is exceeded
+func TestCreateRelease_ViewTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake GitHub CLI is implemented as a shell script")
+	}
+
+	fakeGhPath := filepath.Join(t.TempDir(), "gh")
+	script := "#!/bin/sh\nif [ \"$2\" = \"view\" ]; then exec sleep 30; fi\n"
+	//nolint:gosec // the fake CLI must be executable
+	require.NoError(t, os.WriteFile(fakeGhPath, []byte(script), 0700))
+
+	ghCli := &GitHubCli{ExecutablePath: fakeGhPath}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	release, err := ghCli.CreateRelease(ctx, t.TempDir(), "v1.0.0", map[string]string{}, []string{})
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Nil(t, release)
+	require.Less(t, time.Since(start), 10*time.Second)
+}
+
+func TestRepositoryApiArgs(t *testing.T) {
+	require.Equal(t,
+		[]string{"api", "repos/{owner}/{repo}/git/ref/tags/v1.0.0"},
+		repositoryApiArgs("", "git/ref/tags/v1.0.0"),
+	)
+	require.Equal(t,
+		[]string{"api", "repos/owner/repo/git/ref/tags/v1.0.0"},
+		repositoryApiArgs("owner/repo", "git/ref/tags/v1.0.0"),
+	)
+	require.Equal(t,
+		[]string{"api", "--hostname", "github.contoso.com", "repos/owner/repo/git/ref/tags/v1.0.0"},
+		repositoryApiArgs("github.contoso.com/owner/repo", "git/ref/tags/v1.0.0"),
+	)
+}
+
+func TestTagExists(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake GitHub CLI is implemented as a shell script")
+	}
+
+	tests := []struct {
+		name        string
+		script      string
+		expected    bool
+		expectError bool
+	}{
+		{name: "Exists", script: "#!/bin/sh\necho '{}'\n", expected: true},
+		{name: "NotFound", script: "#!/bin/sh\necho 'gh: Not Found (HTTP 404)' >&2\nexit 1\n", expected: false},
+		{
+			name:        "Failure",
+			script:      "#!/bin/sh\necho 'gh: Bad Gateway (HTTP 502)' >&2\nexit 1\n",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGhPath := filepath.Join(t.TempDir(), "gh")
+			//nolint:gosec // the fake CLI must be executable
+			require.NoError(t, os.WriteFile(fakeGhPath, []byte(tt.script), 0700))
+
+			ghCli := &GitHubCli{ExecutablePath: fakeGhPath}
+			exists, err := ghCli.TagExists(context.Background(), t.TempDir(), "owner/repo", "v1.0.0")
+
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, exists)
+		})
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/azure/azure-dev/cli/azd/extensions/microsoft.azd.extensions/internal"
+	"github.com/azure/azure-dev/cli/azd/pkg/common"
+)
+
+var (
+	ErrReleaseAlreadyExists = fmt.Errorf("release already exists")
+	ErrReleaseNotFound      = fmt.Errorf("release not found")
+	ErrUnsupportedOption    = fmt.Errorf("option not supported by GitLab releases")
+)
+
+// GitLabCli provides access to GitLab CLI functionality
+type GitLabCli struct {
+	// ExecutablePath is the path to the GitLab CLI executable
+	ExecutablePath string
+}
+
+// Repository represents a GitLab project
+type Repository struct {
+	Name string `json:"path_with_namespace"`
+	Url  string `json:"web_url"`
+}
+
+// Release represents a GitLab release
+type Release struct {
+	Name    string        `json:"name"`
+	TagName string        `json:"tag_name"`
+	Links   ReleaseLinks  `json:"_links"`
+	Assets  ReleaseAssets `json:"assets"`
+}
+
+// ReleaseLinks contains the links associated with a GitLab release
+type ReleaseLinks struct {
+	Self string `json:"self"`
+}
+
+// ReleaseAssets contains the assets attached to a GitLab release
+type ReleaseAssets struct {
+	Links []*ReleaseAssetLink `json:"links"`
+}
+
+// ReleaseAssetLink represents a file attached to a GitLab release
+type ReleaseAssetLink struct {
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
+
+// NewGitLabCli creates a new GitLab CLI wrapper
+func NewGitLabCli() (*GitLabCli, error) {
+	// Try to find GitLab CLI in PATH
+	glabPath, err := exec.LookPath("glab")
+	if err != nil {
+		return &GitLabCli{
+			ExecutablePath: "glab", // Default to "glab" and let validation handle errors later
+		}, nil
+	}
+
+	return &GitLabCli{
+		ExecutablePath: glabPath,
+	}, nil
+}
+
+// IsInstalled checks if the GitLab CLI is installed and available
+func (glab *GitLabCli) IsInstalled() (bool, error) {
+	/* #nosec G204 - Subprocess launched with a potential tainted input or cmd arguments */
+	cmd := exec.Command(glab.ExecutablePath, "--version")
+	err := cmd.Run()
+	if err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+// CheckAndGetInstallError checks if GitLab CLI is installed and returns a UserFriendlyError if it's not
+func (glab *GitLabCli) CheckAndGetInstallError() error {
+	installed, err := glab.IsInstalled()
+	if err != nil || !installed {
+		return internal.NewUserFriendlyError(
+			"GitLab CLI is required for this operation",
+			glab.getInstallInstructions(),
+		)
+	}
+	return nil
+}
+
+// ViewRepository gets information about a GitLab project.
+// When repo is empty the project of the current directory is used.
+func (glab *GitLabCli) ViewRepository(cwd string, repo string) (*Repository, error) {
+	host, repo := splitRepositoryHost(repo)
+	resultBytes, err := glab.api(context.Background(), cwd, host, projectPath(repo))
+	if err != nil {
+		return nil, common.NewDetailedError("Failed to get GitLab repository", err)
+	}
+
+	var repoResult *Repository
+	if err := json.Unmarshal(resultBytes, &repoResult); err != nil {
+		return nil, fmt.Errorf("failed to deserialize command output: %w, Command output: %s", err, string(resultBytes))
+	}
+
+	return repoResult, nil
+}
+
+// ViewRelease gets information about a GitLab release
+func (glab *GitLabCli) ViewRelease(ctx context.Context, cwd string, repo string, tagName string) (*Release, error) {
+	host, repo := splitRepositoryHost(repo)
+	endpoint := fmt.Sprintf("%s/releases/%s", projectPath(repo), url.PathEscape(tagName))
+	resultBytes, err := glab.api(ctx, cwd, host, endpoint)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return nil, fmt.Errorf("%s, %w", err.Error(), ErrReleaseNotFound)
+		}
+
+		return nil, err
+	}
+
+	var releaseResult *Release
+	if err := json.Unmarshal(resultBytes, &releaseResult); err != nil {
+		return nil, fmt.Errorf("failed to deserialize command output: %w, Command output: %s", err, string(resultBytes))
+	}
+
+	return releaseResult, nil
+}
+
+// CreateReleaseArgs returns the GitLab CLI arguments used to create a release
+func (glab *GitLabCli) CreateReleaseArgs(tagName string, opts map[string]string, assets []string) []string {
+	args := []string{"release", "create", tagName}
+
+	if title := opts["title"]; title != "" {
+		args = append(args, "--name", title)
+	}
+	if notes := opts["notes"]; notes != "" {
+		args = append(args, "--notes", notes)
+	}
+	if repo := opts["repo"]; repo != "" {
+		args = append(args, "--repo", repo)
+	}
+
+	// Add assets
+	args = append(args, assets...)
+
+	return args
+}
+
+// ValidateReleaseOptions returns an error when the options contain settings that GitLab releases do not support
+func (glab *GitLabCli) ValidateReleaseOptions(opts map[string]string) error {
+	// GitLab releases do not support pre-release or draft states
+	for _, flag := range []string{"prerelease", "draft"} {
+		if opts[flag] == "true" {
+			return fmt.Errorf("--%s: %w", flag, ErrUnsupportedOption)
+		}
+	}
+
+	return nil
+}
+
+// CreateRelease creates a new GitLab release.
+// The GitLab CLI process is killed if the context is cancelled before the release is created.
+func (glab *GitLabCli) CreateRelease(
+	ctx context.Context,
+	cwd string,
+	tagName string,
+	opts map[string]string,
+	assets []string,
+) (*Release, error) {
+	if err := glab.ValidateReleaseOptions(opts); err != nil {
+		return nil, err
+	}
+
+	args := glab.CreateReleaseArgs(tagName, opts, assets)
+
+	// First create the release
+	/* #nosec G204 - Subprocess launched with a potential tainted input or cmd arguments */
+	cmd := exec.CommandContext(ctx, glab.ExecutablePath, args...)
+	cmd.Dir = cwd
+
+	resultBytes, err := cmd.CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("release command did not complete: %w", ctx.Err())
+		}
+
+		errorMessage := string(resultBytes)
+		if strings.Contains(errorMessage, "Release already exists") {
+			return nil, fmt.Errorf("%s, %w", errorMessage, ErrReleaseAlreadyExists)
+		}
+
+		return nil, fmt.Errorf("failed to run command: %w, Command output: %s", err, errorMessage)
+	}
+
+	// Then fetch the created release details to return a full Release object
+	return glab.ViewRelease(ctx, cwd, opts["repo"], tagName)
+}
+
+// DeleteRelease deletes a GitLab release and its associated tag
+func (glab *GitLabCli) DeleteRelease(cwd string, repo string, tagName string) error {
+	args := []string{"release", "delete", tagName, "--yes", "--with-tag"}
+	if repo != "" {
+		args = append(args, "--repo", repo)
+	}
+
+	/* #nosec G204 - Subprocess launched with a potential tainted input or cmd arguments */
+	cmd := exec.Command(glab.ExecutablePath, args...)
+	cmd.Dir = cwd
+
+	resultBytes, err := cmd.CombinedOutput()
+	if err != nil {
+		errorMessage := string(resultBytes)
+		if strings.Contains(errorMessage, "404") {
+			return fmt.Errorf("%s, %w", errorMessage, ErrReleaseNotFound)
+		}
+
+		return fmt.Errorf("failed to run command: %w, Command output: %s", err, errorMessage)
+	}
+
+	return nil
+}
+
+// TagExists returns true when the tag exists in the GitLab project, whether or not it has a release
+func (glab *GitLabCli) TagExists(ctx context.Context, cwd string, repo string, tagName string) (bool, error) {
+	host, repo := splitRepositoryHost(repo)
+	endpoint := fmt.Sprintf("%s/repository/tags/%s", projectPath(repo), url.PathEscape(tagName))
+	if _, err := glab.api(ctx, cwd, host, endpoint); err != nil {
+		if ctx.Err() == nil && strings.Contains(err.Error(), "404") {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteTag deletes a tag from the GitLab project
+func (glab *GitLabCli) DeleteTag(cwd string, repo string, tagName string) error {
+	host, repo := splitRepositoryHost(repo)
+	endpoint := fmt.Sprintf("%s/repository/tags/%s", projectPath(repo), url.PathEscape(tagName))
+	_, err := glab.api(context.Background(), cwd, host, endpoint, "--method", "DELETE")
+
+	return err
+}
+
+// api invokes the GitLab REST API through the GitLab CLI and returns the raw response.
+// When host is empty the GitLab CLI default host is used. The process is killed if the context is cancelled.
+func (glab *GitLabCli) api(
+	ctx context.Context,
+	cwd string,
+	host string,
+	endpoint string,
+	extraArgs ...string,
+) ([]byte, error) {
+	args := []string{"api", endpoint}
+	if host != "" {
+		args = append(args, "--hostname", host)
+	}
+
+	args = append(args, extraArgs...)
+
+	/* #nosec G204 - Subprocess launched with a potential tainted input or cmd arguments */
+	cmd := exec.CommandContext(ctx, glab.ExecutablePath, args...)
+	cmd.Dir = cwd
+
+	resultBytes, err := cmd.CombinedOutput()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("api command did not complete: %w", ctx.Err())
+		}
+
+		return nil, fmt.Errorf("failed to run command: %w, Command output: %s", err, string(resultBytes))
+	}
+
+	return resultBytes, nil
+}
+
+// splitRepositoryHost splits a HOST/OWNER/REPO repository into its host and project path.
+// The first segment is treated as a host when it contains a dot (e.g. gitlab.contoso.com).
+func splitRepositoryHost(repo string) (string, string) {
+	host, path, has := strings.Cut(repo, "/")
+	if has && strings.Contains(host, ".") && strings.Contains(path, "/") {
+		return host, path
+	}
+
+	return "", repo
+}
+
+// projectPath returns the API path of a GitLab project. When repo is empty the GitLab CLI
+// resolves the project from the repository of the current directory.
+func projectPath(repo string) string {
+	if repo == "" {
+		return "projects/:fullpath"
+	}
+
+	return fmt.Sprintf("projects/%s", url.PathEscape(repo))
+}
+
+// getInstallInstructions returns OS-specific instructions for installing GitLab CLI
+func (glab *GitLabCli) getInstallInstructions() string {
+	var installCommand string
+
+	switch runtime.GOOS {
+	case "windows":
+		installCommand = "winget install -e --id GLab.GLab"
+	case "darwin":
+		installCommand = "brew install glab"
+	case "linux":
+		installCommand = "See https://gitlab.com/gitlab-org/cli#installation for your distribution"
+	default:
+		installCommand = "See https://gitlab.com/gitlab-org/cli#installation for installation instructions"
+	}
+
+	return fmt.Sprintf(`GitLab CLI (glab) is required for this operation but was not found.
+
+Installation instructions for %s:
+%s
+
+After installing, authenticate using:
+glab auth login
+
+For more information, visit: https://gitlab.com/gitlab-org/cli
+`, runtime.GOOS, installCommand)
+}
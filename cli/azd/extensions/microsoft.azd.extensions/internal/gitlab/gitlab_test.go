@@ -0,0 +1,163 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License.
+
+package gitlab
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateReleaseArgs(t *testing.T) {
+	glabCli := &GitLabCli{ExecutablePath: "glab"}
+
+	t.Run("AllOptions", func(t *testing.T) {
+		args := glabCli.CreateReleaseArgs(
+			"v1.0.0",
+			map[string]string{
+				"title": "Release 1.0.0",
+				"notes": "Initial release",
+				"repo":  "group/subgroup/project",
+			},
+			[]string{"a.zip", "b.tar.gz"},
+		)
+
+		require.Equal(t, []string{
+			"release", "create", "v1.0.0",
+			"--name", "Release 1.0.0",
+			"--notes", "Initial release",
+			"--repo", "group/subgroup/project",
+			"a.zip", "b.tar.gz",
+		}, args)
+	})
+
+	t.Run("EmptyOptionsOmitted", func(t *testing.T) {
+		args := glabCli.CreateReleaseArgs("v1.0.0", map[string]string{"title": "", "notes": ""}, []string{"a.zip"})
+
+		require.Equal(t, []string{"release", "create", "v1.0.0", "a.zip"}, args)
+	})
+}
+
+func TestValidateReleaseOptions(t *testing.T) {
+	glabCli := &GitLabCli{ExecutablePath: "glab"}
+
+	require.NoError(t, glabCli.ValidateReleaseOptions(map[string]string{"title": "Release"}))
+	require.ErrorIs(t, glabCli.ValidateReleaseOptions(map[string]string{"prerelease": "true"}), ErrUnsupportedOption)
+	require.ErrorIs(t, glabCli.ValidateReleaseOptions(map[string]string{"draft": "true"}), ErrUnsupportedOption)
+}
+
+func TestProjectPath(t *testing.T) {
+	require.Equal(t, "projects/:fullpath", projectPath(""))
+	require.Equal(t, "projects/owner%2Frepo", projectPath("owner/repo"))
+	require.Equal(t, "projects/group%2Fsubgroup%2Fproject", projectPath("group/subgroup/project"))
+}
+
+func TestSplitRepositoryHost(t *testing.T) {
+	tests := []struct {
+		name         string
+		repo         string
+		expectedHost string
+		expectedPath string
+	}{
+		{name: "Empty", repo: "", expectedHost: "", expectedPath: ""},
+		{name: "OwnerRepo", repo: "owner/repo", expectedHost: "", expectedPath: "owner/repo"},
+		{name: "Subgroup", repo: "group/subgroup/project", expectedHost: "", expectedPath: "group/subgroup/project"},
+		{
+			name:         "SelfHosted",
+			repo:         "gitlab.contoso.com/group/project",
+			expectedHost: "gitlab.contoso.com",
+			expectedPath: "group/project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, path := splitRepositoryHost(tt.repo)
+			require.Equal(t, tt.expectedHost, host)
+			require.Equal(t, tt.expectedPath, path)
+		})
+	}
+}
+
+// TestCreateRelease_Timeout verifies a hung GitLab CLI process is killed when the context deadline is exceeded
+func TestCreateRelease_Timeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake GitLab CLI is implemented as a shell script")
+	}
+
+	fakeGlabPath := filepath.Join(t.TempDir(), "glab")
+	//nolint:gosec // the fake CLI must be executable
+	require.NoError(t, os.WriteFile(fakeGlabPath, []byte("#!/bin/sh\nexec sleep 30\n"), 0700))
+
+	glabCli := &GitLabCli{ExecutablePath: fakeGlabPath}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	release, err := glabCli.CreateRelease(ctx, t.TempDir(), "v1.0.0", map[string]string{}, []string{})
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Nil(t, release)
+	require.Less(t, time.Since(start), 10*time.Second)
+}
+
+// TestCreateRelease_ViewTimeout verifies a hung GitLab CLI process looking up the created release is killed
+// when the context deadline is exceeded
+func TestCreateRelease_ViewTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake GitLab CLI is implemented as a shell script")
+	}
+
+	fakeGlabPath := filepath.Join(t.TempDir(), "glab")
+	script := "#!/bin/sh\nif [ \"$1\" = \"api\" ]; then exec sleep 30; fi\n"
+	//nolint:gosec // the fake CLI must be executable
+	require.NoError(t, os.WriteFile(fakeGlabPath, []byte(script), 0700))
+
+	glabCli := &GitLabCli{ExecutablePath: fakeGlabPath}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	release, err := glabCli.CreateRelease(ctx, t.TempDir(), "v1.0.0", map[string]string{}, []string{})
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Nil(t, release)
+	require.Less(t, time.Since(start), 10*time.Second)
+}
+
+func TestTagExists(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake GitLab CLI is implemented as a shell script")
+	}
+
+	tests := []struct {
+		name     string
+		script   string
+		expected bool
+	}{
+		{name: "Exists", script: "#!/bin/sh\necho '{}'\n", expected: true},
+		{name: "NotFound", script: "#!/bin/sh\necho 'glab: 404 Not Found (HTTP 404)' >&2\nexit 1\n", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeGlabPath := filepath.Join(t.TempDir(), "glab")
+			//nolint:gosec // the fake CLI must be executable
+			require.NoError(t, os.WriteFile(fakeGlabPath, []byte(tt.script), 0700))
+
+			glabCli := &GitLabCli{ExecutablePath: fakeGlabPath}
+			exists, err := glabCli.TagExists(context.Background(), t.TempDir(), "group/project", "v1.0.0")
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, exists)
+		})
+	}
+}
@@ -41,19 +41,22 @@ func main() {
 
 	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		// Check if this is our custom UserFriendlyError type
+		// Errors are written to stderr so they are not mixed with command output
+		errorColor := color.New(color.FgRed)
+
 		var userFriendlyErr *internal.UserFriendlyError
 		if errors.As(err, &userFriendlyErr) {
 			// Display the error message in red
-			color.Red("Error: %v", userFriendlyErr.Error())
+			errorColor.Fprintf(os.Stderr, "Error: %v\n", userFriendlyErr.Error())
 
 			// If we have user details, display them in normal text color
 			if userFriendlyErr.GetUserDetails() != "" {
-				fmt.Println()
-				fmt.Println(userFriendlyErr.GetUserDetails())
+				fmt.Fprintln(os.Stderr)
+				fmt.Fprintln(os.Stderr, userFriendlyErr.GetUserDetails())
 			}
 		} else {
 			// Default error handling for regular errors
-			color.Red("Error: %v", err)
+			errorColor.Fprintf(os.Stderr, "Error: %v\n", err)
 		}
 		os.Exit(1)
 	}